@@ -0,0 +1,86 @@
+package config
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+
+    "gopkg.in/yaml.v3"
+)
+
+// Source describes one forge account forklift should harvest from, modeled
+// on gickup's per-source configuration blocks: a type, an owner, optional
+// auth, and a set of filters applied before anything is cloned.
+type Source struct {
+    Type            string   `yaml:"type"`
+    Owner           string   `yaml:"owner"`
+    Token           string   `yaml:"token"`
+    TokenFile       string   `yaml:"token_file"`
+    BaseURL         string   `yaml:"base_url"`
+    Include         []string `yaml:"include"`
+    Exclude         []string `yaml:"exclude"`
+    ExcludeOrgs     []string `yaml:"exclude_orgs"`
+    ExcludeForks    bool     `yaml:"exclude_forks"`
+    ExcludeArchived bool     `yaml:"exclude_archived"`
+    Languages       []string `yaml:"languages"`
+    MinStars        int      `yaml:"min_stars"`
+}
+
+// ResolveToken returns the source's access token, reading TokenFile when
+// Token was left blank so secrets don't have to live in forklift.yaml.
+func (s Source) ResolveToken() (string, error) {
+    if s.Token != "" {
+        return s.Token, nil
+    }
+    if s.TokenFile == "" {
+        return "", nil
+    }
+
+    data, err := os.ReadFile(s.TokenFile)
+    if err != nil {
+        return "", fmt.Errorf("failed to read token_file %s: %w", s.TokenFile, err)
+    }
+
+    return strings.TrimSpace(string(data)), nil
+}
+
+// Destination controls where harvested repositories land on disk.
+type Destination struct {
+    Path       string `yaml:"path"`
+    Structured bool   `yaml:"structured"`
+}
+
+// RepoPath returns the directory a repository from the given hoster/owner
+// should be cloned into. A Structured destination lays repos out as
+// <path>/<hoster>/<owner>/<repo> so multiple sources can share one root
+// without name collisions.
+func (d Destination) RepoPath(hoster, owner, repo string) string {
+    if d.Structured {
+        return filepath.Join(d.Path, hoster, owner, repo)
+    }
+    return filepath.Join(d.Path, repo)
+}
+
+// Config is the top-level shape of forklift.yaml: a list of sources to pull
+// from and where to put the results. It drives runForklift non-interactively
+// so a harvest can be scheduled from cron.
+type Config struct {
+    Sources     []Source    `yaml:"sources"`
+    Destination Destination `yaml:"destination"`
+}
+
+// Load reads and parses a forklift.yaml config file from path.
+func Load(path string) (*Config, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+    }
+
+    var cfg Config
+    if err := yaml.Unmarshal(data, &cfg); err != nil {
+        return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+    }
+
+    return &cfg, nil
+}
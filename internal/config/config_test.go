@@ -0,0 +1,49 @@
+package config
+
+import (
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func TestSourceResolveToken(t *testing.T) {
+    t.Run("inline token wins", func(t *testing.T) {
+        s := Source{Token: "inline", TokenFile: "ignored"}
+        got, err := s.ResolveToken()
+        if err != nil || got != "inline" {
+            t.Fatalf("ResolveToken() = %q, %v, want %q, nil", got, err, "inline")
+        }
+    })
+
+    t.Run("reads token file", func(t *testing.T) {
+        path := filepath.Join(t.TempDir(), "token")
+        if err := os.WriteFile(path, []byte("from-file\n"), 0600); err != nil {
+            t.Fatal(err)
+        }
+
+        s := Source{TokenFile: path}
+        got, err := s.ResolveToken()
+        if err != nil || got != "from-file" {
+            t.Fatalf("ResolveToken() = %q, %v, want %q, nil", got, err, "from-file")
+        }
+    })
+
+    t.Run("neither set returns empty", func(t *testing.T) {
+        got, err := (Source{}).ResolveToken()
+        if err != nil || got != "" {
+            t.Fatalf("ResolveToken() = %q, %v, want empty, nil", got, err)
+        }
+    })
+}
+
+func TestDestinationRepoPath(t *testing.T) {
+    flat := Destination{Path: "/repos"}
+    if got, want := flat.RepoPath("github", "acme", "widget"), filepath.Join("/repos", "widget"); got != want {
+        t.Errorf("RepoPath() = %q, want %q", got, want)
+    }
+
+    structured := Destination{Path: "/repos", Structured: true}
+    if got, want := structured.RepoPath("github", "acme", "widget"), filepath.Join("/repos", "github", "acme", "widget"); got != want {
+        t.Errorf("RepoPath() = %q, want %q", got, want)
+    }
+}
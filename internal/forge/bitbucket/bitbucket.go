@@ -0,0 +1,81 @@
+// Package bitbucket implements forge.Provider against the Bitbucket Cloud
+// API.
+package bitbucket
+
+import (
+    "context"
+    "fmt"
+
+    "forklift/internal/forge"
+
+    bb "github.com/ktrysmt/go-bitbucket"
+)
+
+func init() {
+    forge.Register("bitbucket", New)
+}
+
+type Client struct {
+    client *bb.Client
+}
+
+// New builds a Bitbucket provider from cfg. cfg.Token is passed through
+// as-is and sent as an OAuth bearer token; app passwords, which need a
+// username alongside them, are not supported.
+func New(cfg forge.ProviderConfig) (forge.Provider, error) {
+    if cfg.Token == "" {
+        return nil, fmt.Errorf("bitbucket provider requires a token")
+    }
+    return &Client{client: bb.NewOAuthbearerToken(cfg.Token)}, nil
+}
+
+func (c *Client) Kind() string { return "bitbucket" }
+
+func (c *Client) Name() string { return "bitbucket.org" }
+
+// DiscoverRepositories lists owner's (workspace) repositories, applying
+// filter (if any) before a repo is added to the result set.
+func (c *Client) DiscoverRepositories(ctx context.Context,
+                                     owner string, filter *forge.Filter) ([]forge.Repository, error) {
+    if filter.ExcludesOwner(owner) {
+        return nil, nil
+    }
+
+    res, err := c.client.Repositories.ListForAccount(&bb.RepositoriesOptions{
+        Owner: owner,
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    var allRepos []forge.Repository
+
+    for _, repo := range res.Items {
+        language := repo.Language
+        if language == "" {
+            language = "Unknown"
+        }
+
+        candidate := forge.Repository{
+            Name:        repo.Slug,
+            Description: repo.Description,
+            CloneURL:    fmt.Sprintf("https://bitbucket.org/%s/%s.git", owner, repo.Slug),
+            SSHURL:      fmt.Sprintf("git@bitbucket.org:%s/%s.git", owner, repo.Slug),
+            Language:    language,
+            Fork:        repo.Parent != nil,
+            Archived:    false, // Bitbucket Cloud has no archived-repo concept
+        }
+
+        if filter == nil {
+            if candidate.Fork {
+                continue
+            }
+        } else if !filter.Match(candidate) {
+            continue
+        }
+
+        allRepos = append(allRepos, candidate)
+    }
+
+    return allRepos, nil
+}
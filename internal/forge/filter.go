@@ -0,0 +1,75 @@
+package forge
+
+import (
+    "path"
+    "strings"
+)
+
+// Filter narrows which repositories DiscoverRepositories returns. It is
+// applied to each repo before it is appended to the result set, so a
+// filtered-out repo never has to flow through the later harvesting stages.
+type Filter struct {
+    Include         []string
+    Exclude         []string
+    ExcludeOrgs     []string
+    ExcludeForks    bool
+    ExcludeArchived bool
+    Languages       []string
+    MinStars        int
+}
+
+// Match reports whether repo passes every configured filter.
+func (f *Filter) Match(repo Repository) bool {
+    if f == nil {
+        return true
+    }
+
+    if f.ExcludeForks && repo.Fork {
+        return false
+    }
+    if f.ExcludeArchived && repo.Archived {
+        return false
+    }
+    if f.MinStars > 0 && repo.Stars < f.MinStars {
+        return false
+    }
+    if len(f.Languages) > 0 && !containsFold(f.Languages, repo.Language) {
+        return false
+    }
+    if len(f.Include) > 0 && !matchAny(f.Include, repo.Name) {
+        return false
+    }
+    if matchAny(f.Exclude, repo.Name) {
+        return false
+    }
+
+    return true
+}
+
+// ExcludesOwner reports whether owner itself should be skipped entirely,
+// letting a multi-source config fan out over many owners while still
+// steering clear of specific orgs.
+func (f *Filter) ExcludesOwner(owner string) bool {
+    if f == nil {
+        return false
+    }
+    return containsFold(f.ExcludeOrgs, owner)
+}
+
+func matchAny(patterns []string, name string) bool {
+    for _, p := range patterns {
+        if ok, _ := path.Match(p, name); ok {
+            return true
+        }
+    }
+    return false
+}
+
+func containsFold(values []string, target string) bool {
+    for _, v := range values {
+        if strings.EqualFold(v, target) {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,44 @@
+package forge
+
+import "testing"
+
+func TestFilterMatch(t *testing.T) {
+    cases := []struct {
+        name   string
+        filter *Filter
+        repo   Repository
+        want   bool
+    }{
+        {"nil filter allows everything", nil, Repository{Name: "x", Fork: true}, true},
+        {"excludes forks", &Filter{ExcludeForks: true}, Repository{Name: "x", Fork: true}, false},
+        {"excludes archived", &Filter{ExcludeArchived: true}, Repository{Name: "x", Archived: true}, false},
+        {"enforces min stars", &Filter{MinStars: 10}, Repository{Name: "x", Stars: 5}, false},
+        {"language allowlist", &Filter{Languages: []string{"Go"}}, Repository{Name: "x", Language: "python"}, false},
+        {"include glob", &Filter{Include: []string{"api-*"}}, Repository{Name: "web"}, false},
+        {"exclude glob wins", &Filter{Include: []string{"foo-*"}, Exclude: []string{"*-archive"}}, Repository{Name: "foo-archive"}, false},
+        {"passes every filter", &Filter{ExcludeForks: true, MinStars: 1}, Repository{Name: "x", Stars: 5}, true},
+    }
+
+    for _, tc := range cases {
+        t.Run(tc.name, func(t *testing.T) {
+            if got := tc.filter.Match(tc.repo); got != tc.want {
+                t.Errorf("Match() = %v, want %v", got, tc.want)
+            }
+        })
+    }
+}
+
+func TestFilterExcludesOwner(t *testing.T) {
+    f := &Filter{ExcludeOrgs: []string{"Acme"}}
+    if !f.ExcludesOwner("acme") {
+        t.Error("expected case-insensitive match on excluded org")
+    }
+    if f.ExcludesOwner("other") {
+        t.Error("did not expect unrelated owner to be excluded")
+    }
+
+    var nilFilter *Filter
+    if nilFilter.ExcludesOwner("anyone") {
+        t.Error("nil filter should never exclude an owner")
+    }
+}
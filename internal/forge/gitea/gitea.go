@@ -0,0 +1,119 @@
+// Package gitea implements forge.Provider against the Gitea API, used for
+// self-hosted Gitea/Forgejo instances.
+package gitea
+
+import (
+    "context"
+    "fmt"
+
+    "forklift/internal/forge"
+
+    "code.gitea.io/sdk/gitea"
+)
+
+func init() {
+    forge.Register("gitea", New)
+}
+
+type Client struct {
+    client  *gitea.Client
+    baseURL string
+}
+
+// New builds a Gitea provider from cfg. BaseURL is required since Gitea is
+// always self-hosted.
+func New(cfg forge.ProviderConfig) (forge.Provider, error) {
+    if cfg.BaseURL == "" {
+        return nil, fmt.Errorf("gitea provider requires a base_url")
+    }
+
+    opts := []gitea.ClientOption{}
+    if cfg.Token != "" {
+        opts = append(opts, gitea.SetToken(cfg.Token))
+    }
+
+    client, err := gitea.NewClient(cfg.BaseURL, opts...)
+    if err != nil {
+        return nil, err
+    }
+
+    return &Client{client: client, baseURL: cfg.BaseURL}, nil
+}
+
+func (c *Client) Kind() string { return "gitea" }
+
+func (c *Client) Name() string { return c.baseURL }
+
+// DiscoverRepositories lists owner's repositories, applying filter (if any)
+// before a repo is added to the result set. owner is tried as an org first;
+// a 404 falls back to the user-repos endpoint so a personal account works
+// too.
+func (c *Client) DiscoverRepositories(ctx context.Context,
+                                     owner string, filter *forge.Filter) ([]forge.Repository, error) {
+    if filter.ExcludesOwner(owner) {
+        return nil, nil
+    }
+
+    var allRepos []forge.Repository
+    page := 1
+    asUser := false
+
+    for {
+        var repos []*gitea.Repository
+        var resp *gitea.Response
+        var err error
+
+        if asUser {
+            repos, resp, err = c.client.ListUserRepos(owner, gitea.ListReposOptions{
+                ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+            })
+        } else {
+            repos, resp, err = c.client.ListOrgRepos(owner, gitea.ListOrgReposOptions{
+                ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+            })
+            if err != nil && resp != nil && resp.StatusCode == 404 && page == 1 {
+                asUser = true
+                repos, resp, err = c.client.ListUserRepos(owner, gitea.ListReposOptions{
+                    ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+                })
+            }
+        }
+        if err != nil {
+            return nil, err
+        }
+
+        for _, repo := range repos {
+            // The Gitea SDK doesn't expose a repo's primary language on the
+            // version this provider targets, so every repo reports Unknown
+            // here until that lands upstream.
+            candidate := forge.Repository{
+                Name:        repo.Name,
+                Description: repo.Description,
+                CloneURL:    repo.CloneURL,
+                SSHURL:      repo.SSHURL,
+                Language:    "Unknown",
+                Stars:       repo.Stars,
+                Size:        repo.Size,
+                Fork:        repo.Fork,
+                Archived:    repo.Archived,
+            }
+
+            if filter == nil {
+                if candidate.Fork {
+                    continue
+                }
+            } else if !filter.Match(candidate) {
+                continue
+            }
+
+            allRepos = append(allRepos, candidate)
+        }
+
+        if len(repos) < 50 {
+            break
+        }
+        page++
+    }
+
+    return allRepos, nil
+}
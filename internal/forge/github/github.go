@@ -0,0 +1,116 @@
+// Package github implements forge.Provider against the GitHub REST API.
+package github
+
+import (
+    "context"
+
+    "forklift/internal/forge"
+
+    "github.com/google/go-github/v58/github"
+    "golang.org/x/oauth2"
+)
+
+func init() {
+    forge.Register("github", New)
+}
+
+type Client struct {
+    client  *github.Client
+    baseURL string
+}
+
+// New builds a GitHub provider from cfg. BaseURL is only needed for GitHub
+// Enterprise; leaving it empty talks to github.com.
+func New(cfg forge.ProviderConfig) (forge.Provider, error) {
+    var httpClient *github.Client
+
+    if cfg.Token != "" {
+        ts := oauth2.StaticTokenSource(
+            &oauth2.Token{AccessToken: cfg.Token},
+        )
+        tc := oauth2.NewClient(context.Background(), ts)
+        httpClient = github.NewClient(tc)
+    } else {
+        httpClient = github.NewClient(nil)
+    }
+
+    if cfg.BaseURL != "" {
+        enterprise, err := httpClient.WithEnterpriseURLs(cfg.BaseURL, cfg.BaseURL)
+        if err != nil {
+            return nil, err
+        }
+        httpClient = enterprise
+    }
+
+    return &Client{client: httpClient, baseURL: cfg.BaseURL}, nil
+}
+
+func (c *Client) Kind() string { return "github" }
+
+func (c *Client) Name() string {
+    if c.baseURL != "" {
+        return c.baseURL
+    }
+    return "github.com"
+}
+
+// DiscoverRepositories lists owner's repositories, applying filter (if any)
+// before a repo is added to the result set. Passing a nil filter preserves
+// forklift's historical default of skipping forks and nothing else.
+func (c *Client) DiscoverRepositories(ctx context.Context,
+                                     owner string, filter *forge.Filter) ([]forge.Repository, error) {
+    if filter.ExcludesOwner(owner) {
+        return nil, nil
+    }
+
+    opt := &github.RepositoryListOptions{
+        ListOptions: github.ListOptions{PerPage: 100},
+        Sort:        "updated", // Sort by most recently updated
+        Direction:   "desc",
+    }
+
+    var allRepos []forge.Repository
+
+    for {
+        repos, resp, err := c.client.Repositories.List(ctx, owner, opt)
+        if err != nil {
+            return nil, err
+        }
+
+        for _, repo := range repos {
+            language := repo.GetLanguage()
+            if language == "" {
+                language = "Unknown"
+            }
+
+            candidate := forge.Repository{
+                Name:        repo.GetName(),
+                Description: repo.GetDescription(),
+                CloneURL:    repo.GetCloneURL(),
+                SSHURL:      repo.GetSSHURL(),
+                Language:    language,
+                Stars:       repo.GetStargazersCount(),
+                Size:        repo.GetSize(),
+                Fork:        repo.GetFork(),
+                Archived:    repo.GetArchived(),
+            }
+
+            if filter == nil {
+                if candidate.Fork {
+                    continue // Skip forks by default
+                }
+            } else if !filter.Match(candidate) {
+                continue
+            }
+
+            allRepos = append(allRepos, candidate)
+        }
+
+        if resp.NextPage == 0 {
+            break
+        }
+        opt.Page = resp.NextPage
+    }
+
+    return allRepos, nil
+}
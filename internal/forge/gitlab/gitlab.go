@@ -0,0 +1,157 @@
+// Package gitlab implements forge.Provider against the GitLab API, for both
+// gitlab.com and self-hosted instances.
+package gitlab
+
+import (
+    "context"
+    "errors"
+    "net/http"
+
+    "forklift/internal/forge"
+
+    "github.com/xanzy/go-gitlab"
+)
+
+func init() {
+    forge.Register("gitlab", New)
+}
+
+type Client struct {
+    client  *gitlab.Client
+    baseURL string
+}
+
+// New builds a GitLab provider from cfg. BaseURL points it at a self-hosted
+// instance; leaving it empty talks to gitlab.com.
+func New(cfg forge.ProviderConfig) (forge.Provider, error) {
+    opts := []gitlab.ClientOptionFunc{}
+    if cfg.BaseURL != "" {
+        opts = append(opts, gitlab.WithBaseURL(cfg.BaseURL))
+    }
+
+    client, err := gitlab.NewClient(cfg.Token, opts...)
+    if err != nil {
+        return nil, err
+    }
+
+    return &Client{client: client, baseURL: cfg.BaseURL}, nil
+}
+
+func (c *Client) Kind() string { return "gitlab" }
+
+func (c *Client) Name() string {
+    if c.baseURL != "" {
+        return c.baseURL
+    }
+    return "gitlab.com"
+}
+
+// DiscoverRepositories lists owner's (group or user) projects, applying
+// filter (if any) before a repo is added to the result set. owner is tried
+// as a group first, since that's the common case; a 404 falls back to the
+// user-projects endpoint so a personal namespace works too.
+func (c *Client) DiscoverRepositories(ctx context.Context,
+                                     owner string, filter *forge.Filter) ([]forge.Repository, error) {
+    if filter.ExcludesOwner(owner) {
+        return nil, nil
+    }
+
+    projects, err := c.listGroupProjects(ctx, owner)
+    if isNotFound(err) {
+        projects, err = c.listUserProjects(ctx, owner)
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    var allRepos []forge.Repository
+    for _, project := range projects {
+        language := "Unknown"
+        if len(project.TagList) > 0 {
+            language = project.TagList[0]
+        }
+
+        // Statistics is only populated when the API was asked for it.
+        size := 0
+        if project.Statistics != nil {
+            size = int(project.Statistics.RepositorySize / 1024)
+        }
+
+        candidate := forge.Repository{
+            Name:        project.Path,
+            Description: project.Description,
+            CloneURL:    project.HTTPURLToRepo,
+            SSHURL:      project.SSHURLToRepo,
+            Language:    language,
+            Stars:       project.StarCount,
+            Size:        size,
+            Fork:        project.ForkedFromProject != nil,
+            Archived:    project.Archived,
+        }
+
+        if filter == nil {
+            if candidate.Fork {
+                continue
+            }
+        } else if !filter.Match(candidate) {
+            continue
+        }
+
+        allRepos = append(allRepos, candidate)
+    }
+
+    return allRepos, nil
+}
+
+func (c *Client) listGroupProjects(ctx context.Context, owner string) ([]*gitlab.Project, error) {
+    opt := &gitlab.ListGroupProjectsOptions{
+        ListOptions: gitlab.ListOptions{PerPage: 100},
+        OrderBy:     gitlab.Ptr("updated_at"),
+        Sort:        gitlab.Ptr("desc"),
+    }
+
+    var all []*gitlab.Project
+    for {
+        projects, resp, err := c.client.Groups.ListGroupProjects(owner, opt, gitlab.WithContext(ctx))
+        if err != nil {
+            return nil, err
+        }
+        all = append(all, projects...)
+
+        if resp == nil || resp.NextPage == 0 {
+            break
+        }
+        opt.Page = resp.NextPage
+    }
+    return all, nil
+}
+
+func (c *Client) listUserProjects(ctx context.Context, owner string) ([]*gitlab.Project, error) {
+    opt := &gitlab.ListProjectsOptions{
+        ListOptions: gitlab.ListOptions{PerPage: 100},
+        OrderBy:     gitlab.Ptr("updated_at"),
+        Sort:        gitlab.Ptr("desc"),
+    }
+
+    var all []*gitlab.Project
+    for {
+        projects, resp, err := c.client.Projects.ListUserProjects(owner, opt, gitlab.WithContext(ctx))
+        if err != nil {
+            return nil, err
+        }
+        all = append(all, projects...)
+
+        if resp == nil || resp.NextPage == 0 {
+            break
+        }
+        opt.Page = resp.NextPage
+    }
+    return all, nil
+}
+
+// isNotFound reports whether err is a GitLab 404 response, used to detect
+// "owner isn't a group" so callers can retry against the user endpoint.
+func isNotFound(err error) bool {
+    var errResp *gitlab.ErrorResponse
+    return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound
+}
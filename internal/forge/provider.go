@@ -0,0 +1,65 @@
+package forge
+
+import (
+    "context"
+    "fmt"
+)
+
+// Repository is the shared shape every forge backend normalizes its
+// repositories into, so the rest of forklift never has to care which
+// hoster a repo came from.
+type Repository struct {
+    Name        string
+    Description string
+    CloneURL    string
+    SSHURL      string
+    Language    string
+    Stars       int
+    Size        int // in KB
+    Fork        bool
+    Archived    bool
+}
+
+// Provider is implemented by every forge backend forklift can harvest
+// from (GitHub, GitLab, Gitea, Bitbucket, ...).
+type Provider interface {
+    // Name identifies this provider instance, e.g. the host it talks to.
+    Name() string
+    // Kind returns the backend type, e.g. "github" or "gitlab".
+    Kind() string
+    // DiscoverRepositories lists owner's repositories, applying filter (if
+    // any) before a repo is added to the result set.
+    DiscoverRepositories(ctx context.Context, owner string, filter *Filter) ([]Repository, error)
+}
+
+// ProviderConfig carries the settings a provider Factory needs to build a
+// Provider: credentials plus, for self-hosted backends, the instance URL.
+type ProviderConfig struct {
+    Token   string
+    BaseURL string
+}
+
+// Factory constructs a Provider from a ProviderConfig. Each backend package
+// registers one under its Kind via Register.
+type Factory func(cfg ProviderConfig) (Provider, error)
+
+var registry = map[string]Factory{}
+
+// Register makes a provider Factory available under kind. It is meant to be
+// called from a backend package's init(), mirroring database/sql drivers -
+// adding a new provider only means importing its package, never touching
+// the registry's callers.
+func Register(kind string, factory Factory) {
+    registry[kind] = factory
+}
+
+// New looks up the Factory registered for kind and builds a Provider from
+// cfg. kind must have been registered already, usually via a blank import
+// of the backend's package.
+func New(kind string, cfg ProviderConfig) (Provider, error) {
+    factory, ok := registry[kind]
+    if !ok {
+        return nil, fmt.Errorf("unknown forge provider %q (is its package imported?)", kind)
+    }
+    return factory(cfg)
+}
@@ -0,0 +1,12 @@
+// Package providers registers every forge backend forklift ships with.
+// Importing this package (for its side effects) is the only wiring main.go
+// needs - adding a new backend means adding a blank import here, not
+// touching main.go.
+package providers
+
+import (
+    _ "forklift/internal/forge/bitbucket"
+    _ "forklift/internal/forge/gitea"
+    _ "forklift/internal/forge/github"
+    _ "forklift/internal/forge/gitlab"
+)
@@ -0,0 +1,77 @@
+package harvester
+
+import (
+    "fmt"
+    "os"
+
+    "github.com/go-git/go-git/v5/plumbing/transport"
+    "github.com/go-git/go-git/v5/plumbing/transport/http"
+    "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+// Auth wraps the go-git AuthMethod a clone should use, resolved ahead of
+// time so forklift can work in headless/CI environments without a
+// configured system git.
+type Auth struct {
+    Method transport.AuthMethod
+}
+
+// NewSSHAgentAuth builds an Auth from the ssh-agent listening on
+// SSH_AUTH_SOCK, for the "git" user every forge expects over SSH.
+func NewSSHAgentAuth() (*Auth, error) {
+    method, err := ssh.NewSSHAgentAuth("git")
+    if err != nil {
+        return nil, fmt.Errorf("failed to connect to ssh-agent: %w", err)
+    }
+    return &Auth{Method: method}, nil
+}
+
+// NewSSHKeyAuth builds an Auth from a private key file, optionally
+// decrypted with a passphrase read from the passphraseEnv environment
+// variable.
+func NewSSHKeyAuth(keyPath, passphraseEnv string) (*Auth, error) {
+    passphrase := ""
+    if passphraseEnv != "" {
+        passphrase = os.Getenv(passphraseEnv)
+    }
+
+    method, err := ssh.NewPublicKeysFromFile("git", keyPath, passphrase)
+    if err != nil {
+        return nil, fmt.Errorf("failed to load ssh key %s: %w", keyPath, err)
+    }
+    return &Auth{Method: method}, nil
+}
+
+// NewHTTPBasicAuth builds an Auth for HTTPS clones, using token as the
+// password under the fixed placeholder username "forklift", which forges
+// that accept PATs over basic auth do not check.
+func NewHTTPBasicAuth(token string) *Auth {
+    return &Auth{Method: &http.BasicAuth{Username: "forklift", Password: token}}
+}
+
+// ResolveAuth picks the best available auth method. For HTTPS it uses HTTP
+// basic auth with token; for SSH it tries an explicit SSH key file, then
+// ssh-agent. It returns nil if none can be resolved, so callers can fall
+// back to the interactive system-git check instead.
+func ResolveAuth(sshKeyPath, sshKeyPassphraseEnv, token string, useHTTPS bool) *Auth {
+    if useHTTPS {
+        if token != "" {
+            return NewHTTPBasicAuth(token)
+        }
+        return nil
+    }
+
+    if sshKeyPath != "" {
+        if auth, err := NewSSHKeyAuth(sshKeyPath, sshKeyPassphraseEnv); err == nil {
+            return auth
+        }
+    }
+
+    if os.Getenv("SSH_AUTH_SOCK") != "" {
+        if auth, err := NewSSHAgentAuth(); err == nil {
+            return auth
+        }
+    }
+
+    return nil
+}
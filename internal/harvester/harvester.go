@@ -1,24 +1,156 @@
 package harvester
 
 import (
+    "context"
     "fmt"
+    "io/fs"
     "os"
+    "os/exec"
+    "path/filepath"
+    "strings"
+    "sync"
+    "time"
 
     "github.com/go-git/go-git/v5"
+    "github.com/rs/zerolog/log"
 )
 
+// LFSOptions controls whether and how Harvester fetches Git LFS objects
+// after a clone, since go-git does not pull them itself.
+type LFSOptions struct {
+    Enabled bool
+    Include []string
+    Exclude []string
+}
+
+// HarvestOptions bundles every knob Harvester needs. It exists so New can
+// grow new options (like LFSOptions) without breaking its signature.
+type HarvestOptions struct {
+    Recursive bool
+    Jobs      int
+    Update    bool
+    Mirror    bool
+    LFS       LFSOptions
+    Auth      *Auth
+}
+
 type Harvester struct {
-    recursive bool
+    opts HarvestOptions
+}
+
+func New(opts HarvestOptions) *Harvester {
+    if opts.Jobs < 1 {
+        opts.Jobs = 1
+    }
+    return &Harvester{opts: opts}
+}
+
+// RepoSpec is the minimal description of a repository needed to harvest it
+// concurrently - forge.Repository is intentionally not referenced here so
+// the harvester package stays independent of any particular forge.
+type RepoSpec struct {
+    Name string
+    URL  string
+    Path string
+}
+
+// HarvestResult reports the outcome of harvesting a single RepoSpec.
+type HarvestResult struct {
+    Name     string
+    Path     string
+    Duration time.Duration
+    Bytes    int64
+    Retries  int
+    Err      error
 }
 
-func New(recursive bool) *Harvester {
-    return &Harvester{recursive: recursive}
+// HarvestRepositories clones (or, in update mode, updates) every repo in repos using a pool
+// of h.opts.Jobs workers, streaming a HarvestResult per repo as it completes. The
+// returned channel is closed once all repos have been processed. Cancelling
+// ctx aborts in-flight clones and stops any workers still waiting for work.
+func (h *Harvester) HarvestRepositories(ctx context.Context, repos []RepoSpec) <-chan HarvestResult {
+    work := make(chan RepoSpec)
+    results := make(chan HarvestResult)
+
+    var wg sync.WaitGroup
+    for i := 0; i < h.opts.Jobs; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for spec := range work {
+                results <- h.harvestOne(ctx, spec)
+            }
+        }()
+    }
+
+    go func() {
+        defer close(work)
+        for _, spec := range repos {
+            select {
+            case work <- spec:
+            case <-ctx.Done():
+                return
+            }
+        }
+    }()
+
+    go func() {
+        wg.Wait()
+        close(results)
+    }()
+
+    return results
 }
 
-func (h *Harvester) HarvestRepository(url, path string) error {
-    // Check if directory already exists
+func (h *Harvester) harvestOne(ctx context.Context, spec RepoSpec) HarvestResult {
+    sublog := log.With().Str("stage", "harvest").Str("repo", spec.Name).Logger()
+
+    start := time.Now()
+    var retries int
+    var err error
+
+    for attempt := 0; attempt <= 1; attempt++ {
+        if attempt > 0 {
+            retries++
+            sublog.Warn().Err(err).Int("attempt", attempt+1).Msg("retrying harvest")
+        }
+        err = h.HarvestRepository(ctx, spec.URL, spec.Path)
+        if err == nil || ctx.Err() != nil {
+            break
+        }
+    }
+
+    result := HarvestResult{
+        Name:     spec.Name,
+        Path:     spec.Path,
+        Duration: time.Since(start),
+        Retries:  retries,
+        Err:      err,
+    }
+
+    if err != nil {
+        sublog.Error().Err(err).Dur("duration", result.Duration).Msg("harvest failed")
+        return result
+    }
+
+    result.Bytes, _ = dirSize(spec.Path)
+    sublog.Info().Dur("duration", result.Duration).Int64("bytes", result.Bytes).Msg("harvest succeeded")
+    return result
+}
+
+func (h *Harvester) HarvestRepository(ctx context.Context, url, path string) error {
+    // If the destination already exists, either update it in place or
+    // report the conflict, depending on the harvester's mode. A directory
+    // that exists but isn't actually a git repo falls through to a clone
+    // instead of failing UpdateRepository's git.PlainOpen.
     if _, err := os.Stat(path); err == nil {
-        return fmt.Errorf("directory %s already exists", path)
+        if h.opts.Update {
+            if _, openErr := git.PlainOpen(path); openErr == nil {
+                return h.UpdateRepository(ctx, path)
+            }
+        } else {
+            return fmt.Errorf("directory %s already exists", path)
+        }
     }
 
     // Clone options
@@ -26,23 +158,138 @@ func (h *Harvester) HarvestRepository(url, path string) error {
         URL:      url,
         Progress: os.Stdout,
     }
+    if h.opts.Auth != nil {
+        cloneOptions.Auth = h.opts.Auth.Method
+    }
+    if h.opts.Mirror {
+        // Mirror clones fetch every ref, not just the default branch, so
+        // the destination can stand in as a full backup of the remote.
+        cloneOptions.Mirror = true
+    }
 
     // Harvest the repository
-    repo, err := git.PlainClone(path, false, cloneOptions)
+    repo, err := git.PlainCloneContext(ctx, path, h.opts.Mirror, cloneOptions)
     if err != nil {
         return fmt.Errorf("failed to harvest: %w", err)
     }
 
-    // Handle submodules if recursive is enabled
-    if h.recursive {
+    // Handle submodules if recursive is enabled (mirrors have no worktree)
+    if h.opts.Recursive && !h.opts.Mirror {
         if err := h.cultivateSubmodules(repo, path); err != nil {
             fmt.Printf("  WARNING: failed to cultivate submodules: %v\n", err)
         }
     }
 
+    if !h.opts.Mirror {
+        h.fetchLFS(path)
+    }
+
     return nil
 }
 
+// UpdateRepository brings an already-harvested repository up to date in
+// place: it opens the existing clone, fetches every remote, fast-forwards
+// the currently checked out (default) branch, and, when Recursive is set,
+// recursively updates submodules. It is safe to call repeatedly across the full selection set -
+// an up-to-date repo is simply a no-op.
+func (h *Harvester) UpdateRepository(ctx context.Context, path string) error {
+    repo, err := git.PlainOpen(path)
+    if err != nil {
+        return fmt.Errorf("failed to open %s for update: %w", path, err)
+    }
+
+    remotes, err := repo.Remotes()
+    if err != nil {
+        return fmt.Errorf("failed to list remotes for %s: %w", path, err)
+    }
+
+    fetchOptions := &git.FetchOptions{Progress: os.Stdout}
+    pullOptions := &git.PullOptions{RemoteName: "origin", Progress: os.Stdout}
+    if h.opts.Auth != nil {
+        fetchOptions.Auth = h.opts.Auth.Method
+        pullOptions.Auth = h.opts.Auth.Method
+    }
+
+    for _, remote := range remotes {
+        err := remote.FetchContext(ctx, fetchOptions)
+        if err != nil && err != git.NoErrAlreadyUpToDate {
+            return fmt.Errorf("failed to fetch remote %s for %s: %w",
+                remote.Config().Name, path, err)
+        }
+    }
+
+    worktree, err := repo.Worktree()
+    if err != nil {
+        // Bare/mirror repos have no worktree - fetching every remote above
+        // is the whole update for those.
+        return nil
+    }
+
+    if err := worktree.PullContext(ctx, pullOptions); err != nil &&
+        err != git.NoErrAlreadyUpToDate {
+        return fmt.Errorf("failed to fast-forward %s: %w", path, err)
+    }
+
+    if h.opts.Recursive {
+        if err := h.cultivateSubmodules(repo, path); err != nil {
+            fmt.Printf("  WARNING: failed to cultivate submodules: %v\n", err)
+        }
+    }
+
+    h.fetchLFS(path)
+
+    return nil
+}
+
+// fetchLFS shells out to the system git-lfs binary to pull in LFS objects
+// go-git's clone can't fetch itself, mirroring how gickup's local harvester
+// handles LFS. It is a best-effort step: a repo without LFS-tracked paths is
+// skipped silently, and a missing git-lfs binary or a failed fetch/checkout
+// just produces a warning, never a hard failure.
+func (h *Harvester) fetchLFS(path string) {
+    if !h.opts.LFS.Enabled || !usesLFS(path) {
+        return
+    }
+
+    if _, err := exec.LookPath("git-lfs"); err != nil {
+        fmt.Printf("  WARNING: git-lfs not installed, skipping LFS objects for %s\n", path)
+        return
+    }
+
+    fetchArgs := []string{"-C", path, "lfs", "fetch", "--all"}
+    for _, pattern := range h.opts.LFS.Include {
+        fetchArgs = append(fetchArgs, "--include", pattern)
+    }
+    for _, pattern := range h.opts.LFS.Exclude {
+        fetchArgs = append(fetchArgs, "--exclude", pattern)
+    }
+
+    if err := runGit(fetchArgs...); err != nil {
+        fmt.Printf("  WARNING: git lfs fetch failed for %s: %v\n", path, err)
+        return
+    }
+
+    if err := runGit("-C", path, "lfs", "checkout"); err != nil {
+        fmt.Printf("  WARNING: git lfs checkout failed for %s: %v\n", path, err)
+    }
+}
+
+// usesLFS reports whether path's .gitattributes references the lfs filter.
+func usesLFS(path string) bool {
+    data, err := os.ReadFile(filepath.Join(path, ".gitattributes"))
+    if err != nil {
+        return false
+    }
+    return strings.Contains(string(data), "filter=lfs")
+}
+
+func runGit(args ...string) error {
+    cmd := exec.Command("git", args...)
+    cmd.Stdout = os.Stdout
+    cmd.Stderr = os.Stderr
+    return cmd.Run()
+}
+
 func (h *Harvester) cultivateSubmodules(repo *git.Repository, repoPath string) error {
     worktree, err := repo.Worktree()
     if err != nil {
@@ -67,10 +314,31 @@ func (h *Harvester) cultivateSubmodules(repo *git.Repository, repoPath string) e
             Init: true,
             RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
         }); err != nil {
-            return fmt.Errorf("failed to cultivate submodule %s: %w", 
+            return fmt.Errorf("failed to cultivate submodule %s: %w",
                 submodule.Config().Name, err)
         }
     }
 
     return nil
-}
\ No newline at end of file
+}
+
+// dirSize sums the size of every regular file under path, used to report
+// how many bytes a harvest pulled down.
+func dirSize(path string) (int64, error) {
+    var size int64
+    err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+        if err != nil {
+            return err
+        }
+        if d.IsDir() {
+            return nil
+        }
+        info, err := d.Info()
+        if err != nil {
+            return err
+        }
+        size += info.Size()
+        return nil
+    })
+    return size, err
+}
@@ -0,0 +1,125 @@
+package harvester
+
+import (
+    "context"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+
+    "github.com/go-git/go-git/v5"
+    "github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// newLocalRepo creates a small git repo with one commit on disk and returns
+// its path, usable as a clone source without hitting the network.
+func newLocalRepo(t *testing.T) string {
+    t.Helper()
+
+    dir := t.TempDir()
+    repo, err := git.PlainInit(dir, false)
+    if err != nil {
+        t.Fatalf("failed to init source repo: %v", err)
+    }
+
+    if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello"), 0644); err != nil {
+        t.Fatalf("failed to write file: %v", err)
+    }
+
+    worktree, err := repo.Worktree()
+    if err != nil {
+        t.Fatalf("failed to get worktree: %v", err)
+    }
+    if _, err := worktree.Add("README.md"); err != nil {
+        t.Fatalf("failed to stage file: %v", err)
+    }
+    if _, err := worktree.Commit("initial commit", &git.CommitOptions{
+        Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+    }); err != nil {
+        t.Fatalf("failed to commit: %v", err)
+    }
+
+    return dir
+}
+
+func TestHarvestRepositoriesSucceeds(t *testing.T) {
+    source := newLocalRepo(t)
+    dest := filepath.Join(t.TempDir(), "widget")
+
+    h := New(HarvestOptions{Jobs: 2})
+    results := h.HarvestRepositories(context.Background(), []RepoSpec{
+        {Name: "widget", URL: source, Path: dest},
+    })
+
+    var got []HarvestResult
+    for result := range results {
+        got = append(got, result)
+    }
+
+    if len(got) != 1 {
+        t.Fatalf("got %d results, want 1", len(got))
+    }
+    if got[0].Err != nil {
+        t.Fatalf("unexpected harvest error: %v", got[0].Err)
+    }
+    if got[0].Retries != 0 {
+        t.Errorf("Retries = %d, want 0 for a successful clone", got[0].Retries)
+    }
+    if _, err := os.Stat(filepath.Join(dest, "README.md")); err != nil {
+        t.Errorf("expected cloned file to exist: %v", err)
+    }
+}
+
+func TestHarvestRepositoriesRetriesOnFailure(t *testing.T) {
+    h := New(HarvestOptions{Jobs: 1})
+    results := h.HarvestRepositories(context.Background(), []RepoSpec{
+        {Name: "missing", URL: filepath.Join(t.TempDir(), "does-not-exist"), Path: filepath.Join(t.TempDir(), "missing")},
+    })
+
+    var got []HarvestResult
+    for result := range results {
+        got = append(got, result)
+    }
+
+    if len(got) != 1 {
+        t.Fatalf("got %d results, want 1", len(got))
+    }
+    if got[0].Err == nil {
+        t.Fatal("expected an error harvesting a nonexistent repo")
+    }
+    if got[0].Retries != 1 {
+        t.Errorf("Retries = %d, want 1 (one retry after the first failed attempt)", got[0].Retries)
+    }
+}
+
+// TestHarvestRepositoriesCancelledContext checks that a pre-cancelled
+// context makes HarvestRepositories wind down promptly instead of hanging:
+// the dispatcher's select over "send work" vs "ctx.Done()" may still let a
+// queued repo through, but any such attempt must fail, and
+// the results channel must still close well within the deadline below.
+func TestHarvestRepositoriesCancelledContext(t *testing.T) {
+    source := newLocalRepo(t)
+
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+
+    h := New(HarvestOptions{Jobs: 2})
+    results := h.HarvestRepositories(ctx, []RepoSpec{
+        {Name: "widget", URL: source, Path: filepath.Join(t.TempDir(), "widget")},
+    })
+
+    deadline := time.After(2 * time.Second)
+    for {
+        select {
+        case result, ok := <-results:
+            if !ok {
+                return
+            }
+            if result.Err == nil {
+                t.Fatalf("expected a harvest against an already-cancelled context to fail, got %+v", result)
+            }
+        case <-deadline:
+            t.Fatal("HarvestRepositories did not close its results channel promptly after cancellation")
+        }
+    }
+}
@@ -0,0 +1,319 @@
+// Package scanner runs a lightweight, trufflehog-style secret detection
+// pass over a harvested repository's working tree.
+package scanner
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "io/fs"
+    "math"
+    "os"
+    "path/filepath"
+    "regexp"
+    "sync"
+
+    "gopkg.in/yaml.v3"
+)
+
+// Severity ranks how concerning a Finding is, low to critical.
+type Severity string
+
+const (
+    SeverityLow      Severity = "low"
+    SeverityMedium   Severity = "medium"
+    SeverityHigh     Severity = "high"
+    SeverityCritical Severity = "critical"
+)
+
+var severityRank = map[Severity]int{
+    SeverityLow:      0,
+    SeverityMedium:   1,
+    SeverityHigh:     2,
+    SeverityCritical: 3,
+}
+
+// AtLeast reports whether s is at least as severe as threshold. An unknown
+// severity - including the zero value, meaning "no finding" - ranks below
+// SeverityLow rather than defaulting to rank 0, so it never satisfies an
+// AtLeast(SeverityLow) check.
+func (s Severity) AtLeast(threshold Severity) bool {
+    rank, ok := severityRank[s]
+    if !ok {
+        return false
+    }
+    return rank >= severityRank[threshold]
+}
+
+// ParseSeverity validates s against the known severities, for flags like
+// --scan-fail-on where a silently-ignored typo would be a security hole.
+func ParseSeverity(s string) (Severity, error) {
+    severity := Severity(s)
+    if _, ok := severityRank[severity]; !ok {
+        return "", fmt.Errorf("unknown severity %q (want one of: low, medium, high, critical)", s)
+    }
+    return severity, nil
+}
+
+// Rule describes one thing to look for: either a plain regex match, or - if
+// EntropyMin is set - a regex that finds candidate tokens which are then
+// scored by Shannon entropy.
+type Rule struct {
+    Name       string   `yaml:"name"`
+    Regex      string   `yaml:"regex"`
+    EntropyMin float64  `yaml:"entropy_min"`
+    Severity   Severity `yaml:"severity"`
+}
+
+type compiledRule struct {
+    Rule
+    pattern *regexp.Regexp
+}
+
+// DefaultRules returns forklift's built-in detectors: AWS access keys,
+// GitHub personal access tokens, private-key PEM headers, and generic
+// high-entropy base64/hex tokens.
+func DefaultRules() []Rule {
+    return []Rule{
+        {Name: "aws-access-key-id", Regex: `AKIA[0-9A-Z]{16}`, Severity: SeverityHigh},
+        {Name: "github-pat", Regex: `gh[pousr]_[A-Za-z0-9]{36,255}`, Severity: SeverityCritical},
+        {Name: "private-key-pem", Regex: `-----BEGIN (RSA |EC |OPENSSH |DSA |)PRIVATE KEY-----`, Severity: SeverityCritical},
+        {Name: "high-entropy-hex", Regex: `[0-9a-fA-F]{32,}`, EntropyMin: 4.5, Severity: SeverityMedium},
+        {Name: "high-entropy-base64", Regex: `[A-Za-z0-9+/]{24,}={0,2}`, EntropyMin: 4.5, Severity: SeverityMedium},
+    }
+}
+
+// LoadRules reads a YAML list of Rule from path, for --scan-rules.
+func LoadRules(path string) ([]Rule, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read scan rules %s: %w", path, err)
+    }
+
+    var rules []Rule
+    if err := yaml.Unmarshal(data, &rules); err != nil {
+        return nil, fmt.Errorf("failed to parse scan rules %s: %w", path, err)
+    }
+
+    return rules, nil
+}
+
+// Finding is a single rule match within a harvested file.
+type Finding struct {
+    Rule     string   `json:"rule"`
+    File     string   `json:"file"`
+    Line     int      `json:"line"`
+    Match    string   `json:"match"`
+    Severity Severity `json:"severity"`
+}
+
+// Report is the forklift-scan.json shape written per repo and aggregated
+// at the destination root. Error is set when the walk aborted partway
+// through (e.g. a permission-denied file) - Findings in that case only
+// cover what was scanned before the failure, not the whole repo.
+type Report struct {
+    Repo     string    `json:"repo"`
+    Findings []Finding `json:"findings"`
+    Error    string    `json:"error,omitempty"`
+    Path     string    `json:"-"`
+}
+
+// ScanTarget is the minimal description of a harvested repo to scan.
+type ScanTarget struct {
+    Name string
+    Path string
+}
+
+// Scanner walks harvested working trees looking for secrets.
+type Scanner struct {
+    rules   []compiledRule
+    maxSize int64
+    jobs    int
+}
+
+// New compiles rules and builds a Scanner. maxSize caps how large a file
+// can be before it's skipped; jobs caps how many repos are scanned at once.
+func New(rules []Rule, maxSize int64, jobs int) (*Scanner, error) {
+    if jobs < 1 {
+        jobs = 1
+    }
+
+    compiled := make([]compiledRule, 0, len(rules))
+    for _, rule := range rules {
+        pattern, err := regexp.Compile(rule.Regex)
+        if err != nil {
+            return nil, fmt.Errorf("invalid scan rule %q: %w", rule.Name, err)
+        }
+        compiled = append(compiled, compiledRule{Rule: rule, pattern: pattern})
+    }
+
+    return &Scanner{rules: compiled, maxSize: maxSize, jobs: jobs}, nil
+}
+
+// ScanRepositories scans every target arriving on targets using a pool of
+// s.jobs workers, gated separately from the harvest worker pool to avoid CPU
+// starvation. targets is a channel (rather than a slice) so a caller can
+// feed it repos as they finish harvesting, pipelining the scan with the
+// harvest instead of waiting for the whole harvest to complete first. The
+// returned channel closes once targets is closed and every in-flight scan
+// has reported - or immediately once ctx is cancelled, so a worker waiting
+// for its next target doesn't outlive a ctrl-C and leave a caller blocked
+// sending to targets with no receiver left.
+func (s *Scanner) ScanRepositories(ctx context.Context, targets <-chan ScanTarget) <-chan Report {
+    reports := make(chan Report)
+
+    var wg sync.WaitGroup
+    for i := 0; i < s.jobs; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for {
+                select {
+                case target, ok := <-targets:
+                    if !ok {
+                        return
+                    }
+                    report, err := s.ScanRepository(ctx, target.Name, target.Path)
+                    if err != nil {
+                        report.Error = err.Error()
+                    }
+                    select {
+                    case reports <- report:
+                    case <-ctx.Done():
+                        return
+                    }
+                case <-ctx.Done():
+                    return
+                }
+            }
+        }()
+    }
+
+    go func() {
+        wg.Wait()
+        close(reports)
+    }()
+
+    return reports
+}
+
+// ScanRepository walks path applying every rule to files under the size
+// cap, skipping .git internals.
+func (s *Scanner) ScanRepository(ctx context.Context, repoName, path string) (Report, error) {
+    report := Report{Repo: repoName, Path: path}
+
+    err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+        if err != nil {
+            return err
+        }
+        if ctx.Err() != nil {
+            return ctx.Err()
+        }
+        if d.IsDir() {
+            if d.Name() == ".git" {
+                return filepath.SkipDir
+            }
+            return nil
+        }
+
+        info, err := d.Info()
+        if err != nil || info.Size() > s.maxSize {
+            return nil
+        }
+
+        data, err := os.ReadFile(file)
+        if err != nil || looksBinary(data) {
+            return nil
+        }
+
+        rel, err := filepath.Rel(path, file)
+        if err != nil {
+            rel = file
+        }
+
+        report.Findings = append(report.Findings, s.scanBlob(rel, data)...)
+        return nil
+    })
+
+    return report, err
+}
+
+func (s *Scanner) scanBlob(file string, data []byte) []Finding {
+    var findings []Finding
+
+    for _, rule := range s.rules {
+        for _, loc := range rule.pattern.FindAllIndex(data, -1) {
+            match := string(data[loc[0]:loc[1]])
+
+            if rule.EntropyMin > 0 && shannonEntropy(match) < rule.EntropyMin {
+                continue
+            }
+
+            findings = append(findings, Finding{
+                Rule:     rule.Name,
+                File:     file,
+                Line:     lineOf(data, loc[0]),
+                Match:    match,
+                Severity: rule.Severity,
+            })
+        }
+    }
+
+    return findings
+}
+
+// WriteReport writes report as forklift-scan.json under dir.
+func WriteReport(report Report, dir string) error {
+    data, err := json.MarshalIndent(report, "", "  ")
+    if err != nil {
+        return err
+    }
+    return os.WriteFile(filepath.Join(dir, "forklift-scan.json"), data, 0644)
+}
+
+// Aggregate combines per-repo reports into one, for the destination root.
+func Aggregate(reports []Report) Report {
+    agg := Report{Repo: "aggregate"}
+    for _, r := range reports {
+        for _, f := range r.Findings {
+            f.File = r.Repo + "/" + f.File
+            agg.Findings = append(agg.Findings, f)
+        }
+    }
+    return agg
+}
+
+func lineOf(data []byte, offset int) int {
+    return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func looksBinary(data []byte) bool {
+    if len(data) > 8000 {
+        data = data[:8000]
+    }
+    return bytes.IndexByte(data, 0) != -1
+}
+
+func shannonEntropy(s string) float64 {
+    if len(s) == 0 {
+        return 0
+    }
+
+    var counts [256]int
+    for _, b := range []byte(s) {
+        counts[b]++
+    }
+
+    var entropy float64
+    length := float64(len(s))
+    for _, count := range counts {
+        if count == 0 {
+            continue
+        }
+        p := float64(count) / length
+        entropy -= p * math.Log2(p)
+    }
+
+    return entropy
+}
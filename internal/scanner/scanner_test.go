@@ -0,0 +1,47 @@
+package scanner
+
+import "testing"
+
+func TestSeverityAtLeast(t *testing.T) {
+    cases := []struct {
+        name      string
+        severity  Severity
+        threshold Severity
+        want      bool
+    }{
+        {"equal ranks satisfy threshold", SeverityLow, SeverityLow, true},
+        {"higher satisfies lower threshold", SeverityCritical, SeverityLow, true},
+        {"lower does not satisfy higher threshold", SeverityLow, SeverityHigh, false},
+        {"zero value never satisfies any threshold", Severity(""), SeverityLow, false},
+        {"unknown severity never satisfies any threshold", Severity("bogus"), SeverityLow, false},
+    }
+
+    for _, tc := range cases {
+        t.Run(tc.name, func(t *testing.T) {
+            if got := tc.severity.AtLeast(tc.threshold); got != tc.want {
+                t.Errorf("AtLeast() = %v, want %v", got, tc.want)
+            }
+        })
+    }
+}
+
+func TestParseSeverity(t *testing.T) {
+    if _, err := ParseSeverity("high"); err != nil {
+        t.Errorf("ParseSeverity(\"high\") returned unexpected error: %v", err)
+    }
+    if _, err := ParseSeverity("extreme"); err == nil {
+        t.Error("ParseSeverity(\"extreme\") should have returned an error")
+    }
+}
+
+func TestShannonEntropy(t *testing.T) {
+    if got := shannonEntropy(""); got != 0 {
+        t.Errorf("shannonEntropy(\"\") = %v, want 0", got)
+    }
+
+    low := shannonEntropy("aaaaaaaaaa")
+    high := shannonEntropy("aZ3kQ9mP7x")
+    if low >= high {
+        t.Errorf("expected repeated-character entropy (%v) to be lower than varied entropy (%v)", low, high)
+    }
+}
@@ -6,25 +6,79 @@ import (
     "fmt"
     "os"
     "os/exec"
+    "os/signal"
     "path/filepath"
+    "runtime"
     "sort"
     "strconv"
     "strings"
+    "sync"
+    "syscall"
+    "time"
 
+    "forklift/internal/config"
     "forklift/internal/harvester"
     "forklift/internal/forge"
+    _ "forklift/internal/forge/providers"
+    "forklift/internal/scanner"
 
     "github.com/spf13/cobra"
 )
 
 var (
-    token       string
-    destination string
-    recursive   bool
-    useHTTPS    bool
-    filterLang  string
+    token        string
+    destination  string
+    recursive    bool
+    useHTTPS     bool
+    filterLang   string
+    jobs         int
+    update       bool
+    mirror       bool
+    configPath   string
+    providerKind string
+    providerURL  string
+    lfsEnabled   bool
+    noLFS        bool
+    lfsInclude   []string
+    lfsExclude   []string
+    scanEnabled         bool
+    scanRules           string
+    scanFailOn          string
+    scanJobs            int
+    sshKey              string
+    sshKeyPassphraseEnv string
 )
 
+// maxScanFileSize caps how large a file the secret scanner will read into
+// memory, matching trufflehog-style scanners that skip huge blobs.
+const maxScanFileSize = 10 * 1024 * 1024
+
+// harvestOptions assembles the harvester.HarvestOptions shared by both the
+// interactive and config-driven harvest paths from the current flag values.
+func harvestOptions(auth *harvester.Auth) harvester.HarvestOptions {
+    return harvester.HarvestOptions{
+        Recursive: recursive,
+        Jobs:      jobs,
+        Update:    update,
+        Mirror:    mirror,
+        LFS: harvester.LFSOptions{
+            Enabled: lfsEnabled && !noLFS,
+            Include: lfsInclude,
+            Exclude: lfsExclude,
+        },
+        Auth: auth,
+    }
+}
+
+// defaultJobs mirrors the repo's own worker-pool sizing: use all cores up
+// to a sensible cap so a harvest run doesn't saturate the host by default.
+func defaultJobs() int {
+    if n := runtime.NumCPU(); n < 4 {
+        return n
+    }
+    return 4
+}
+
 func main() {
     var rootCmd = &cobra.Command{
         Use:   "forklift [username/organization]",
@@ -32,20 +86,52 @@ func main() {
         Long: `Forklift - A tool to harvest and organize open source repositories.
 Select which repositories to collect and where to cultivate them locally.
 Uses SSH by default for easy development workflow.`,
-        Args: cobra.ExactArgs(1),
+        Args: cobra.MaximumNArgs(1),
         Run:  runForklift,
     }
 
-    rootCmd.Flags().StringVarP(&token, "token", "t", "", 
+    rootCmd.Flags().StringVarP(&token, "token", "t", "",
         "GitHub personal access token (optional for public repos)")
+    rootCmd.Flags().StringVarP(&configPath, "config", "c", "",
+        "Path to a forklift.yaml config for a non-interactive, multi-source harvest")
     rootCmd.Flags().StringVarP(&destination, "dest", "d", "", 
         "Destination directory (will prompt if not provided)")
     rootCmd.Flags().BoolVarP(&recursive, "recursive", "r", true, 
         "Harvest repositories recursively with submodules")
     rootCmd.Flags().BoolVar(&useHTTPS, "https", false, 
         "Use HTTPS instead of SSH for cloning (useful for read-only access)")
-    rootCmd.Flags().StringVarP(&filterLang, "language", "l", "", 
+    rootCmd.Flags().StringVarP(&filterLang, "language", "l", "",
         "Filter repositories by programming language (e.g., Go, Python, JavaScript)")
+    rootCmd.Flags().IntVarP(&jobs, "jobs", "j", defaultJobs(),
+        "Number of repositories to harvest concurrently")
+    rootCmd.Flags().BoolVarP(&update, "update", "u", false,
+        "Update already-harvested repositories in place instead of failing")
+    rootCmd.Flags().BoolVar(&mirror, "mirror", false,
+        "Maintain a bare mirror of every ref, suitable for org backups")
+    rootCmd.Flags().StringVarP(&providerKind, "provider", "p", "github",
+        "Forge backend to harvest from: github, gitlab, gitea, or bitbucket")
+    rootCmd.Flags().StringVar(&providerURL, "provider-url", "",
+        "Base URL for a self-hosted provider instance (required for gitea)")
+    rootCmd.Flags().BoolVar(&lfsEnabled, "lfs", false,
+        "Fetch Git LFS objects after cloning (requires the git-lfs binary)")
+    rootCmd.Flags().BoolVar(&noLFS, "no-lfs", false,
+        "Disable LFS fetching even if --lfs is set")
+    rootCmd.Flags().StringSliceVar(&lfsInclude, "lfs-include", nil,
+        "Glob patterns of LFS paths to fetch")
+    rootCmd.Flags().StringSliceVar(&lfsExclude, "lfs-exclude", nil,
+        "Glob patterns of LFS paths to skip")
+    rootCmd.Flags().BoolVar(&scanEnabled, "scan", false,
+        "Run a secret-detection pass over each harvested repository")
+    rootCmd.Flags().StringVar(&scanRules, "scan-rules", "",
+        "Path to a YAML file of name+regex+entropy-min detectors (defaults to the built-in set)")
+    rootCmd.Flags().StringVar(&scanFailOn, "scan-fail-on", "",
+        "Exit non-zero if a scan finding at or above this severity is found (low, medium, high, critical)")
+    rootCmd.Flags().IntVar(&scanJobs, "scan-jobs", defaultJobs(),
+        "Number of repositories to scan for secrets concurrently")
+    rootCmd.Flags().StringVar(&sshKey, "ssh-key", "",
+        "Path to an SSH private key to authenticate clones with")
+    rootCmd.Flags().StringVar(&sshKeyPassphraseEnv, "ssh-key-passphrase-env", "",
+        "Environment variable holding the passphrase for --ssh-key")
 
     if err := rootCmd.Execute(); err != nil {
         fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -54,19 +140,37 @@ Uses SSH by default for easy development workflow.`,
 }
 
 func runForklift(cmd *cobra.Command, args []string) {
+    if configPath != "" {
+        if err := runFromConfig(configPath); err != nil {
+            fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+            os.Exit(1)
+        }
+        return
+    }
+
+    if len(args) != 1 {
+        fmt.Fprintln(os.Stderr, "Error: a username/organization argument is required unless --config is set")
+        os.Exit(1)
+    }
     owner := args[0]
 
-    // Check SSH setup if not using HTTPS
-    if !useHTTPS {
+    // Resolve an auth method up front so clones work headlessly; only fall
+    // back to the interactive system-git check when nothing can be resolved.
+    auth := harvester.ResolveAuth(sshKey, sshKeyPassphraseEnv, token, useHTTPS)
+    if !useHTTPS && auth == nil {
         checkSSHSetup()
     }
 
-    // Initialize forge client
-    client := forge.NewClient(token)
+    // Initialize the selected forge provider
+    provider, err := forge.New(providerKind, forge.ProviderConfig{Token: token, BaseURL: providerURL})
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+        os.Exit(1)
+    }
 
     // Discover repositories
-    fmt.Printf("Discovering repositories for %s...\n", owner)
-    repos, err := client.DiscoverRepositories(context.Background(), owner)
+    fmt.Printf("Discovering repositories for %s (%s)...\n", owner, provider.Name())
+    repos, err := provider.DiscoverRepositories(context.Background(), owner, nil)
     if err != nil {
         fmt.Fprintf(os.Stderr, "Error discovering repositories: %v\n", err)
         os.Exit(1)
@@ -97,45 +201,84 @@ func runForklift(cmd *cobra.Command, args []string) {
     destDir := getDestinationDirectory()
 
     // Initialize harvester
-    h := harvester.New(recursive)
+    h := harvester.New(harvestOptions(auth))
+
+    // Build the list of repos to harvest concurrently
+    specs := make([]harvester.RepoSpec, 0, len(selectedRepos))
+    for _, repo := range selectedRepos {
+        cloneURL := repo.SSHURL
+        if useHTTPS {
+            cloneURL = repo.CloneURL
+        }
+        specs = append(specs, harvester.RepoSpec{
+            Name: repo.Name,
+            URL:  cloneURL,
+            Path: filepath.Join(destDir, repo.Name),
+        })
+    }
+
+    // Ctrl-C cancels any in-flight clones
+    ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
 
     // Harvest selected repositories
-    fmt.Printf("\nHarvesting %d repositories to %s...\n", 
-        len(selectedRepos), destDir)
-    
+    fmt.Printf("\nHarvesting %d repositories to %s using %d worker(s)...\n",
+        len(specs), destDir, jobs)
+
     if useHTTPS {
         fmt.Println("Using HTTPS for cloning (read-only friendly)")
     } else {
         fmt.Println("Using SSH for cloning (development ready)")
     }
-    
-    for i, repo := range selectedRepos {
-        fmt.Printf("\n[%d/%d] Harvesting %s (%s)...\n", 
-            i+1, len(selectedRepos), repo.Name, repo.Language)
-        
-        repoPath := filepath.Join(destDir, repo.Name)
-        
-        // Choose URL based on protocol preference
-        cloneURL := repo.SSHURL
-        if useHTTPS {
-            cloneURL = repo.CloneURL
+    if mirror {
+        fmt.Println("Mirror mode: maintaining a bare mirror of every ref")
+    } else if update {
+        fmt.Println("Update mode: fast-forwarding existing harvests in place")
+    }
+
+    var scan *scanPipeline
+    if scanEnabled {
+        var err error
+        scan, err = startScan(ctx)
+        if err != nil {
+            fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+            os.Exit(1)
         }
-        
-        if err := h.HarvestRepository(cloneURL, repoPath); err != nil {
-            fmt.Printf("  FAILED to harvest %s: %v\n", repo.Name, err)
-            
-            // If SSH fails, offer to retry with HTTPS
-            if !useHTTPS && strings.Contains(err.Error(), "ssh") {
-                fmt.Printf("  SSH failed, trying HTTPS for %s...\n", repo.Name)
-                if retryErr := h.HarvestRepository(repo.CloneURL, repoPath); retryErr != nil {
-                    fmt.Printf("  HTTPS also failed for %s: %v\n", repo.Name, retryErr)
-                } else {
-                    fmt.Printf("  Successfully harvested %s via HTTPS\n", repo.Name)
-                }
-            }
+        fmt.Printf("Scanning for secrets using %d worker(s) as repos finish harvesting...\n", scanJobs)
+    }
+
+    start := time.Now()
+    var succeeded, failed []harvester.HarvestResult
+    var totalBytes int64
+
+    for result := range h.HarvestRepositories(ctx, specs) {
+        if result.Err != nil {
+            fmt.Printf("  FAILED to harvest %s: %v\n", result.Name, result.Err)
+            failed = append(failed, result)
             continue
         }
-        fmt.Printf("  Successfully harvested %s\n", repo.Name)
+        fmt.Printf("  Successfully harvested %s (%s)\n", result.Name, result.Duration.Round(time.Millisecond))
+        succeeded = append(succeeded, result)
+        totalBytes += result.Bytes
+        if scan != nil {
+            scan.submit(scanner.ScanTarget{Name: result.Name, Path: result.Path})
+        }
+    }
+
+    skipped := len(specs) - len(succeeded) - len(failed)
+
+    fmt.Println("\nHarvest summary:")
+    fmt.Printf("  Succeeded: %d\n", len(succeeded))
+    fmt.Printf("  Failed:    %d\n", len(failed))
+    fmt.Printf("  Skipped:   %d\n", skipped)
+    fmt.Printf("  Total size: %.2f MB\n", float64(totalBytes)/(1024*1024))
+    fmt.Printf("  Wall time:  %s\n", time.Since(start).Round(time.Millisecond))
+
+    if scan != nil {
+        if err := scan.finish(destDir); err != nil {
+            fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+            os.Exit(1)
+        }
     }
 
     fmt.Println("\nHarvest completed! Your open source garden is ready.")
@@ -144,6 +287,241 @@ func runForklift(cmd *cobra.Command, args []string) {
     }
 }
 
+// scanPipeline runs the secret scanner alongside an in-progress harvest: repos
+// are submitted as each clone finishes rather than after the whole harvest
+// completes, so scanning overlaps with harvesting instead of trailing it.
+type scanPipeline struct {
+    ctx     context.Context
+    targets chan scanner.ScanTarget
+    done    chan []scanner.Report
+}
+
+// startScan builds the scanner from --scan-rules (or the built-in defaults)
+// and starts its worker pool listening for submit calls.
+func startScan(ctx context.Context) (*scanPipeline, error) {
+    rules := scanner.DefaultRules()
+    if scanRules != "" {
+        loaded, err := scanner.LoadRules(scanRules)
+        if err != nil {
+            return nil, err
+        }
+        rules = loaded
+    }
+
+    s, err := scanner.New(rules, maxScanFileSize, scanJobs)
+    if err != nil {
+        return nil, err
+    }
+
+    p := &scanPipeline{
+        ctx:     ctx,
+        targets: make(chan scanner.ScanTarget),
+        done:    make(chan []scanner.Report, 1),
+    }
+    reports := s.ScanRepositories(ctx, p.targets)
+
+    go func() {
+        var collected []scanner.Report
+        for report := range reports {
+            if len(report.Findings) > 0 {
+                fmt.Printf("  %s: %d finding(s)\n", report.Repo, len(report.Findings))
+            }
+            if report.Error != "" {
+                fmt.Printf("  WARNING: scan of %s did not finish: %s\n", report.Repo, report.Error)
+            }
+            if err := scanner.WriteReport(report, report.Path); err != nil {
+                fmt.Printf("  WARNING: failed to write scan report for %s: %v\n", report.Repo, err)
+            }
+            collected = append(collected, report)
+        }
+        p.done <- collected
+    }()
+
+    return p, nil
+}
+
+// submit queues a freshly harvested repo for scanning. It must not be called
+// after finish. If ctx is cancelled with no scan worker left to receive it,
+// submit gives up on queuing the target instead of blocking forever.
+func (p *scanPipeline) submit(target scanner.ScanTarget) {
+    select {
+    case p.targets <- target:
+    case <-p.ctx.Done():
+    }
+}
+
+// finish signals that no more repos are coming, waits for every submitted
+// scan to complete, writes the aggregate report at destRoot, and returns an
+// error if --scan-fail-on is set and a finding meets or exceeds that severity.
+func (p *scanPipeline) finish(destRoot string) error {
+    close(p.targets)
+    reports := <-p.done
+
+    if err := scanner.WriteReport(scanner.Aggregate(reports), destRoot); err != nil {
+        fmt.Printf("  WARNING: failed to write aggregate scan report: %v\n", err)
+    }
+
+    if scanFailOn == "" {
+        return nil
+    }
+    threshold, err := scanner.ParseSeverity(scanFailOn)
+    if err != nil {
+        return fmt.Errorf("invalid --scan-fail-on: %w", err)
+    }
+
+    for _, report := range reports {
+        for _, finding := range report.Findings {
+            if finding.Severity.AtLeast(threshold) {
+                return fmt.Errorf("scan found a finding at or above severity %q", scanFailOn)
+            }
+        }
+    }
+
+    return nil
+}
+
+// runFromConfig drives a harvest non-interactively from a forklift.yaml
+// config: every source is discovered and filtered, then each source's repos
+// are cloned through that source's own harvester worker pool, with all
+// sources running concurrently. This is the
+// entry point cron-driven harvests use.
+func runFromConfig(path string) error {
+    cfg, err := config.Load(path)
+    if err != nil {
+        return err
+    }
+
+    ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
+
+    // Each source gets its own Harvester, since Harvester only carries one
+    // auth method per instance and different sources commonly need
+    // different credentials (separate PATs, or a self-hosted Gitea alongside
+    // github.com).
+    type sourceBatch struct {
+        h     *harvester.Harvester
+        specs []harvester.RepoSpec
+    }
+    var batches []sourceBatch
+
+    for _, source := range cfg.Sources {
+        sourceToken, err := source.ResolveToken()
+        if err != nil {
+            return err
+        }
+        // Sources are cloned over HTTPS, so a token is enough auth.
+        sourceAuth := harvester.ResolveAuth("", "", sourceToken, true)
+
+        provider, err := forge.New(source.Type, forge.ProviderConfig{Token: sourceToken, BaseURL: source.BaseURL})
+        if err != nil {
+            return fmt.Errorf("failed to initialize provider for %s: %w", source.Owner, err)
+        }
+        filter := &forge.Filter{
+            Include:         source.Include,
+            Exclude:         source.Exclude,
+            ExcludeOrgs:     source.ExcludeOrgs,
+            ExcludeForks:    source.ExcludeForks,
+            ExcludeArchived: source.ExcludeArchived,
+            Languages:       source.Languages,
+            MinStars:        source.MinStars,
+        }
+
+        fmt.Printf("Discovering repositories for %s (%s)...\n", source.Owner, provider.Name())
+        repos, err := provider.DiscoverRepositories(ctx, source.Owner, filter)
+        if err != nil {
+            return fmt.Errorf("failed to discover repositories for %s: %w", source.Owner, err)
+        }
+
+        var specs []harvester.RepoSpec
+        for _, repo := range repos {
+            specs = append(specs, harvester.RepoSpec{
+                Name: repo.Name,
+                URL:  repo.CloneURL,
+                Path: cfg.Destination.RepoPath(source.Type, source.Owner, repo.Name),
+            })
+        }
+        if len(specs) == 0 {
+            continue
+        }
+
+        batches = append(batches, sourceBatch{h: harvester.New(harvestOptions(sourceAuth)), specs: specs})
+    }
+
+    totalSpecs := 0
+    for _, b := range batches {
+        totalSpecs += len(b.specs)
+    }
+    if totalSpecs == 0 {
+        fmt.Println("No repositories matched any configured source.")
+        return nil
+    }
+
+    var scan *scanPipeline
+    if scanEnabled {
+        var err error
+        scan, err = startScan(ctx)
+        if err != nil {
+            return err
+        }
+        fmt.Printf("Scanning for secrets using %d worker(s) as repos finish harvesting...\n", scanJobs)
+    }
+
+    fmt.Printf("\nHarvesting %d repositories using %d worker(s) per source...\n", totalSpecs, jobs)
+
+    start := time.Now()
+    var succeeded, failed []harvester.HarvestResult
+    var totalBytes int64
+
+    // Fan in every source's harvest so sources run concurrently with each
+    // other, not just within themselves.
+    results := make(chan harvester.HarvestResult)
+    var wg sync.WaitGroup
+    for _, b := range batches {
+        wg.Add(1)
+        go func(b sourceBatch) {
+            defer wg.Done()
+            for result := range b.h.HarvestRepositories(ctx, b.specs) {
+                results <- result
+            }
+        }(b)
+    }
+    go func() {
+        wg.Wait()
+        close(results)
+    }()
+
+    for result := range results {
+        if result.Err != nil {
+            fmt.Printf("  FAILED to harvest %s: %v\n", result.Name, result.Err)
+            failed = append(failed, result)
+            continue
+        }
+        fmt.Printf("  Successfully harvested %s (%s)\n", result.Name, result.Duration.Round(time.Millisecond))
+        succeeded = append(succeeded, result)
+        totalBytes += result.Bytes
+        if scan != nil {
+            scan.submit(scanner.ScanTarget{Name: result.Name, Path: result.Path})
+        }
+    }
+
+    skipped := totalSpecs - len(succeeded) - len(failed)
+
+    fmt.Println("\nHarvest summary:")
+    fmt.Printf("  Succeeded: %d\n", len(succeeded))
+    fmt.Printf("  Failed:    %d\n", len(failed))
+    fmt.Printf("  Skipped:   %d\n", skipped)
+    fmt.Printf("  Total size: %.2f MB\n", float64(totalBytes)/(1024*1024))
+    fmt.Printf("  Wall time:  %s\n", time.Since(start).Round(time.Millisecond))
+
+    if scan != nil {
+        if err := scan.finish(cfg.Destination.Path); err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+
 func checkSSHSetup() {
     // Check if SSH key exists
     homeDir, _ := os.UserHomeDir()